@@ -0,0 +1,110 @@
+// Package config discovers and parses liberadebt.toml/.yaml, letting a
+// user define named profiles (personal, household, ...) instead of
+// repeating flags on every run. Flags passed at runtime still override
+// whatever a profile sets.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// ObligationOverride customizes one obligation by Description when its
+// profile is applied, for details a data file can't express on its own.
+type ObligationOverride struct {
+	TaxDeductible     bool    `toml:"tax_deductible" yaml:"tax_deductible"`
+	ExcludeFromPayoff bool    `toml:"exclude_from_payoff" yaml:"exclude_from_payoff"`
+	MinPaymentFloor   float64 `toml:"min_payment_floor" yaml:"min_payment_floor"`
+}
+
+// Profile is a named, reusable set of defaults for a single household,
+// person, or scenario.
+type Profile struct {
+	Data           string                        `toml:"data" yaml:"data"`
+	Income         string                        `toml:"income" yaml:"income"`
+	Goal           string                        `toml:"goal" yaml:"goal"`
+	Model          string                        `toml:"model" yaml:"model"`
+	Strategy       string                        `toml:"strategy" yaml:"strategy"`
+	PromptTemplate string                        `toml:"prompt_template" yaml:"prompt_template"`
+	OllamaHost     string                        `toml:"ollama_host" yaml:"ollama_host"`
+	Overrides      map[string]ObligationOverride `toml:"overrides" yaml:"overrides"`
+}
+
+// Config is the parsed contents of a liberadebt.toml/.yaml file.
+type Config struct {
+	Profiles map[string]Profile `toml:"profiles" yaml:"profiles"`
+}
+
+// Discover finds the config file to load, preferring, in order: the
+// -config flag, $XDG_CONFIG_HOME/liberadebt/liberadebt.{toml,yaml,yml},
+// then $HOME/.liberadebt.{toml,yaml,yml}. It returns "" if none exist.
+func Discover(configFlag string) (string, error) {
+	if configFlag != "" {
+		return configFlag, nil
+	}
+
+	var candidates []string
+
+	if xdgConfigHome := os.Getenv("XDG_CONFIG_HOME"); xdgConfigHome != "" {
+		for _, name := range []string{"liberadebt.toml", "liberadebt.yaml", "liberadebt.yml"} {
+			candidates = append(candidates, filepath.Join(xdgConfigHome, "liberadebt", name))
+		}
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("error locating home directory: %v", err)
+	}
+	for _, name := range []string{".liberadebt.toml", ".liberadebt.yaml", ".liberadebt.yml"} {
+		candidates = append(candidates, filepath.Join(homeDir, name))
+	}
+
+	for _, candidate := range candidates {
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+
+	return "", nil
+}
+
+// Load parses the config file at path as TOML or YAML, based on its
+// extension.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading config file %s: %v", path, err)
+	}
+
+	var cfg Config
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".toml":
+		if err := toml.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("error parsing TOML config file %s: %v", path, err)
+		}
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &cfg); err != nil {
+			return nil, fmt.Errorf("error parsing YAML config file %s: %v", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported config file extension %q, expected .toml, .yaml, or .yml", ext)
+	}
+
+	return &cfg, nil
+}
+
+// Profile returns the named profile, or an error if it isn't defined.
+func (c *Config) Profile(name string) (Profile, error) {
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return Profile{}, fmt.Errorf("config has no profile named %q", name)
+	}
+
+	return profile, nil
+}
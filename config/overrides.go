@@ -0,0 +1,31 @@
+package config
+
+import "github.com/nomadicGopher/LiberaDebt/obligation"
+
+// ApplyOverrides applies p.Overrides (keyed by Obligation.Description) to
+// obligations, returning a new slice; obligations is left untouched. With
+// no overrides defined, obligations itself is returned.
+func (p Profile) ApplyOverrides(obligations []obligation.Obligation) []obligation.Obligation {
+	if len(p.Overrides) == 0 {
+		return obligations
+	}
+
+	adjusted := make([]obligation.Obligation, len(obligations))
+	for i, o := range obligations {
+		override, ok := p.Overrides[o.Description]
+		if !ok {
+			adjusted[i] = o
+			continue
+		}
+
+		o.TaxDeductible = override.TaxDeductible
+		o.ExcludeFromPayoff = override.ExcludeFromPayoff
+		if override.MinPaymentFloor > o.Payment {
+			o.Payment = override.MinPaymentFloor
+		}
+
+		adjusted[i] = o
+	}
+
+	return adjusted
+}
@@ -0,0 +1,62 @@
+package ledger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Diff summarizes, per account, how much journal activity has moved a
+// balance since a given point in time.
+type Diff struct {
+	Account string
+	Moved   float64 // sum of (debit - credit) amounts since `since`
+}
+
+// DiffSince returns how much each account's balance has moved from entries
+// posted on or after since, sorted by account name.
+func (j *Journal) DiffSince(since time.Time) ([]Diff, error) {
+	entries, err := j.Since(since)
+	if err != nil {
+		return nil, err
+	}
+
+	moved := make(map[string]float64)
+	for _, entry := range entries {
+		moved[entry.Debit] += entry.Amount
+		moved[entry.Credit] -= entry.Amount
+	}
+
+	diffs := make([]Diff, 0, len(moved))
+	for account, amount := range moved {
+		diffs = append(diffs, Diff{Account: account, Moved: amount})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].Account < diffs[j].Account })
+
+	return diffs, nil
+}
+
+// Summary renders diffs as a short, human-readable report, suitable for
+// feeding into the Ollama prompt as "here's what actually happened vs.
+// last month's plan".
+func Summary(diffs []Diff) string {
+	if len(diffs) == 0 {
+		return "No ledger activity recorded yet."
+	}
+
+	var b strings.Builder
+	for _, diff := range diffs {
+		direction := "decreased"
+		amount := diff.Moved
+		if amount > 0 {
+			direction = "increased"
+		} else {
+			amount = -amount
+		}
+		fmt.Fprintf(&b, "%s %s by $%.2f\n", diff.Account, direction, amount)
+	}
+
+	return b.String()
+}
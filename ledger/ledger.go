@@ -0,0 +1,159 @@
+// Package ledger records the state of each obligation over time in an
+// append-only, double-entry journal, so month-over-month progress can be
+// tracked and compared against what the planner predicted.
+//
+// Every account in the journal follows one rule: a debit increases its
+// balance, a credit decreases it. A debt account is therefore debited when
+// interest accrues (the balance owed grows) and credited when a payment
+// lands (the balance owed shrinks) -- the reverse of how a liability
+// account is normally debited/credited, but it keeps Balances() a single
+// running total per account regardless of what kind of account it is.
+package ledger
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Entry is a single double-entry transaction: Amount moves from Credit to
+// Debit (e.g. a payment credits the debt account, shrinking what is owed,
+// and debits the income account it was funded from).
+type Entry struct {
+	Date   time.Time `json:"date"`
+	Debit  string    `json:"debit"`
+	Credit string    `json:"credit"`
+	Amount float64   `json:"amount"`
+	Memo   string    `json:"memo,omitempty"`
+}
+
+// Journal is an append-only, line-delimited JSON ledger of Entries.
+type Journal struct {
+	path string
+}
+
+// DefaultPath returns the ledger file LiberaDebt keeps next to an
+// obligations data file, given that file's directory, e.g. "." (for
+// "./obligations.xlsx") -> "./ledger.jsonl".
+func DefaultPath(dataDir string) string {
+	return dataDir + string(os.PathSeparator) + "ledger.jsonl"
+}
+
+// Open returns a Journal backed by the JSONL file at path, creating it if
+// it doesn't already exist.
+func Open(path string) (*Journal, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("error opening ledger journal %s: %v", path, err)
+	}
+	defer file.Close()
+
+	return &Journal{path: path}, nil
+}
+
+// Post appends entry to the journal.
+func (j *Journal) Post(entry Entry) error {
+	file, err := os.OpenFile(j.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening ledger journal %s: %v", j.path, err)
+	}
+	defer file.Close()
+
+	encoded, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("error marshaling ledger entry: %v", err)
+	}
+
+	if _, err := file.Write(append(encoded, '\n')); err != nil {
+		return fmt.Errorf("error writing ledger entry to %s: %v", j.path, err)
+	}
+
+	return nil
+}
+
+// Entries returns every entry posted to the journal, oldest first.
+func (j *Journal) Entries() ([]Entry, error) {
+	file, err := os.Open(j.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening ledger journal %s: %v", j.path, err)
+	}
+	defer file.Close()
+
+	var entries []Entry
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("error parsing ledger journal %s: %v", j.path, err)
+		}
+
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading ledger journal %s: %v", j.path, err)
+	}
+
+	return entries, nil
+}
+
+// Balances returns the net balance of every account that appears in the
+// journal: a debit increases an account's balance, a credit decreases it.
+func (j *Journal) Balances() (map[string]float64, error) {
+	entries, err := j.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]float64)
+	for _, entry := range entries {
+		balances[entry.Debit] += entry.Amount
+		balances[entry.Credit] -= entry.Amount
+	}
+
+	return balances, nil
+}
+
+// Since returns every entry posted on or after since.
+func (j *Journal) Since(since time.Time) ([]Entry, error) {
+	entries, err := j.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []Entry
+	for _, entry := range entries {
+		if !entry.Date.Before(since) {
+			filtered = append(filtered, entry)
+		}
+	}
+
+	return filtered, nil
+}
+
+// postedSince reports whether any entry touching account, with memo memo,
+// was posted on or after since.
+func (j *Journal) postedSince(account, memo string, since time.Time) (bool, error) {
+	entries, err := j.Since(since)
+	if err != nil {
+		return false, err
+	}
+
+	for _, entry := range entries {
+		if entry.Memo == memo && (entry.Debit == account || entry.Credit == account) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
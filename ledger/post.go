@@ -0,0 +1,134 @@
+package ledger
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nomadicGopher/LiberaDebt/obligation"
+)
+
+// IncomeAccount is credited whenever a payment is posted, standing in for
+// the funds a payment was drawn from.
+const IncomeAccount = "Income:Salary"
+
+// InterestAccount is credited whenever interest accrues on an obligation,
+// since accrued interest is debt the lender earned, not cash that moved.
+const InterestAccount = "Income:InterestAccrued"
+
+// AccountName builds the ledger account path for an obligation, e.g.
+// "Debt:CreditCard:Chase Sapphire".
+func AccountName(o obligation.Obligation) string {
+	return fmt.Sprintf("Debt:%s:%s", sanitize(o.Type), sanitize(o.Description))
+}
+
+func sanitize(s string) string {
+	return strings.ReplaceAll(s, ":", "-")
+}
+
+// Snapshot posts each obligation's current balance as an opening entry
+// against Equity:OpeningBalance, so `liberadebt ledger snapshot` can record
+// a starting point to diff future runs against.
+func Snapshot(j *Journal, obligations []obligation.Obligation, at time.Time) error {
+	for _, o := range obligations {
+		if o.Balance <= 0 {
+			continue
+		}
+
+		if err := j.Post(Entry{
+			Date:   at,
+			Debit:  AccountName(o),
+			Credit: "Equity:OpeningBalance",
+			Amount: o.Balance,
+			Memo:   "snapshot",
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const (
+	increaseMemo = "balance increase since last record"
+	decreaseMemo = "balance decrease since last record"
+)
+
+// RecordActual posts the realized change in each obligation's current
+// balance since the last time it was recorded -- a balance that dropped is
+// posted as a payment (credit to the debt account), a balance that grew is
+// posted as interest/new-charge accrual (debit) -- so a run's actual
+// progress can be diffed against what an earlier plan predicted.
+//
+// This compares against the journal's own running balances, not a plan's
+// projection: a plan is only a forecast of what might happen, while the
+// data file's current balances are what actually did. An account already
+// recorded during at's calendar month is skipped, so calling this more
+// than once in a day (e.g. while comparing -strategy snowball vs
+// avalanche) can't double-post the same change.
+func RecordActual(j *Journal, obligations []obligation.Obligation, at time.Time) error {
+	previous, err := j.Balances()
+	if err != nil {
+		return err
+	}
+
+	monthStart := time.Date(at.Year(), at.Month(), 1, 0, 0, 0, 0, at.Location())
+
+	for _, o := range obligations {
+		account := AccountName(o)
+
+		alreadyRecorded, err := j.recordedSince(account, monthStart)
+		if err != nil {
+			return err
+		}
+		if alreadyRecorded {
+			continue
+		}
+
+		delta := o.Balance - previous[account]
+		if delta == 0 {
+			continue
+		}
+
+		if delta > 0 {
+			if err := j.Post(Entry{
+				Date:   at,
+				Debit:  account,
+				Credit: InterestAccount,
+				Amount: delta,
+				Memo:   increaseMemo,
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := j.Post(Entry{
+			Date:   at,
+			Debit:  IncomeAccount,
+			Credit: account,
+			Amount: -delta,
+			Memo:   decreaseMemo,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordedSince reports whether RecordActual already posted an entry for
+// account on or after since.
+func (j *Journal) recordedSince(account string, since time.Time) (bool, error) {
+	for _, memo := range []string{increaseMemo, decreaseMemo} {
+		recorded, err := j.postedSince(account, memo, since)
+		if err != nil {
+			return false, err
+		}
+		if recorded {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
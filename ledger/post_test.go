@@ -0,0 +1,109 @@
+package ledger
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nomadicGopher/LiberaDebt/obligation"
+)
+
+func openTestJournal(t *testing.T) *Journal {
+	t.Helper()
+
+	journal, err := Open(filepath.Join(t.TempDir(), "ledger.jsonl"))
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+
+	return journal
+}
+
+func TestRecordActual_PostsDeltaAndDedupesWithinMonth(t *testing.T) {
+	journal := openTestJournal(t)
+	card := obligation.Obligation{Description: "Chase Sapphire", Type: "Credit Card", Balance: 1000}
+	account := AccountName(card)
+
+	at := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	if err := Snapshot(journal, []obligation.Obligation{card}, at); err != nil {
+		t.Fatalf("Snapshot returned error: %v", err)
+	}
+
+	card.Balance = 800 // a $200 payment landed since the snapshot
+	later := at.AddDate(0, 0, 15)
+	if err := RecordActual(journal, []obligation.Obligation{card}, later); err != nil {
+		t.Fatalf("RecordActual returned error: %v", err)
+	}
+
+	balances, err := journal.Balances()
+	if err != nil {
+		t.Fatalf("Balances returned error: %v", err)
+	}
+	if got := balances[account]; got != 800 {
+		t.Fatalf("expected recorded balance of 800, got %v", got)
+	}
+
+	// Calling RecordActual again the same month, even with a further
+	// balance change, must not post a second entry for this account.
+	card.Balance = 600
+	if err := RecordActual(journal, []obligation.Obligation{card}, later.AddDate(0, 0, 1)); err != nil {
+		t.Fatalf("second RecordActual returned error: %v", err)
+	}
+
+	balances, err = journal.Balances()
+	if err != nil {
+		t.Fatalf("Balances returned error: %v", err)
+	}
+	if got := balances[account]; got != 800 {
+		t.Fatalf("expected balance to remain 800 after same-month re-run, got %v", got)
+	}
+
+	// A call in the following month should post again.
+	nextMonth := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	if err := RecordActual(journal, []obligation.Obligation{card}, nextMonth); err != nil {
+		t.Fatalf("RecordActual for next month returned error: %v", err)
+	}
+
+	balances, err = journal.Balances()
+	if err != nil {
+		t.Fatalf("Balances returned error: %v", err)
+	}
+	if got := balances[account]; got != 600 {
+		t.Fatalf("expected recorded balance of 600 after next month's record, got %v", got)
+	}
+}
+
+func TestDiffSince_OnlyCountsEntriesOnOrAfter(t *testing.T) {
+	journal := openTestJournal(t)
+	card := obligation.Obligation{Description: "Chase Sapphire", Type: "Credit Card", Balance: 1000}
+	account := AccountName(card)
+
+	old := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if err := Snapshot(journal, []obligation.Obligation{card}, old); err != nil {
+		t.Fatalf("Snapshot returned error: %v", err)
+	}
+
+	cutoff := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	if err := journal.Post(Entry{Date: cutoff, Debit: IncomeAccount, Credit: account, Amount: 150, Memo: "payment"}); err != nil {
+		t.Fatalf("Post returned error: %v", err)
+	}
+
+	diffs, err := journal.DiffSince(cutoff)
+	if err != nil {
+		t.Fatalf("DiffSince returned error: %v", err)
+	}
+	if len(diffs) != 2 {
+		t.Fatalf("expected 2 accounts touched since cutoff, got %d: %+v", len(diffs), diffs)
+	}
+
+	moved := make(map[string]float64, len(diffs))
+	for _, diff := range diffs {
+		moved[diff.Account] = diff.Moved
+	}
+	if moved[account] != -150 {
+		t.Fatalf("expected %s to have moved -150, got %v", account, moved[account])
+	}
+	if moved[IncomeAccount] != 150 {
+		t.Fatalf("expected %s to have moved 150, got %v", IncomeAccount, moved[IncomeAccount])
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/nomadicGopher/LiberaDebt/ledger"
+	"github.com/nomadicGopher/LiberaDebt/loader"
+)
+
+// runLedgerCommand handles `liberadebt ledger <snapshot|record|balances|diff>`.
+func runLedgerCommand(args []string) {
+	if len(args) == 0 {
+		fmt.Println("usage: liberadebt ledger <snapshot|record|balances|diff> [flags]")
+		os.Exit(1)
+	}
+
+	switch args[0] {
+	case "snapshot":
+		runLedgerSnapshot(args[1:])
+	case "record":
+		runLedgerRecord(args[1:])
+	case "balances":
+		runLedgerBalances(args[1:])
+	case "diff":
+		runLedgerDiff(args[1:])
+	default:
+		fmt.Printf("unknown ledger subcommand %q\n", args[0])
+		os.Exit(1)
+	}
+}
+
+// runLedgerSnapshot posts every obligation's current balance to the ledger
+// as a starting point to diff future runs against.
+func runLedgerSnapshot(args []string) {
+	fs := flag.NewFlagSet("ledger snapshot", flag.ExitOnError)
+	dataPath := fs.String("data", "./obligations.xlsx", "Full-path to financial obligations data file (.xlsx, .ofx, or .qfx).")
+	fallbackPayment := fs.Float64("fallbackPayment", 0, "Minimum Monthly Payment to use for OFX/QFX accounts that don't report one.")
+	fs.Parse(args)
+
+	dataLoader, err := loader.New(*dataPath, *fallbackPayment)
+	checkErr(err)
+
+	obligations, err := dataLoader.Load(*dataPath)
+	checkErr(err)
+
+	journal, err := ledger.Open(ledger.DefaultPath(filepath.Dir(*dataPath)))
+	checkErr(err)
+
+	checkErr(ledger.Snapshot(journal, obligations, time.Now()))
+
+	fmt.Println("Snapshot recorded.")
+}
+
+// runLedgerRecord posts the realized change in each obligation's balance
+// since it was last recorded, so month-over-month progress reflects what
+// the data file's current balances actually say happened rather than a
+// plan's projection.
+func runLedgerRecord(args []string) {
+	fs := flag.NewFlagSet("ledger record", flag.ExitOnError)
+	dataPath := fs.String("data", "./obligations.xlsx", "Full-path to financial obligations data file (.xlsx, .ofx, or .qfx).")
+	fallbackPayment := fs.Float64("fallbackPayment", 0, "Minimum Monthly Payment to use for OFX/QFX accounts that don't report one.")
+	fs.Parse(args)
+
+	dataLoader, err := loader.New(*dataPath, *fallbackPayment)
+	checkErr(err)
+
+	obligations, err := dataLoader.Load(*dataPath)
+	checkErr(err)
+
+	journal, err := ledger.Open(ledger.DefaultPath(filepath.Dir(*dataPath)))
+	checkErr(err)
+
+	checkErr(ledger.RecordActual(journal, obligations, time.Now()))
+
+	fmt.Println("Actual progress recorded.")
+}
+
+// runLedgerBalances prints the current running balance of every account
+// in the ledger.
+func runLedgerBalances(args []string) {
+	fs := flag.NewFlagSet("ledger balances", flag.ExitOnError)
+	dataPath := fs.String("data", "./obligations.xlsx", "Full-path to financial obligations data file (.xlsx, .ofx, or .qfx).")
+	fs.Parse(args)
+
+	journal, err := ledger.Open(ledger.DefaultPath(filepath.Dir(*dataPath)))
+	checkErr(err)
+
+	balances, err := journal.Balances()
+	checkErr(err)
+
+	accounts := make([]string, 0, len(balances))
+	for account := range balances {
+		accounts = append(accounts, account)
+	}
+	sort.Strings(accounts)
+
+	for _, account := range accounts {
+		fmt.Printf("%s: $%.2f\n", account, balances[account])
+	}
+}
+
+// runLedgerDiff prints how much every account's balance has moved since
+// a given date.
+func runLedgerDiff(args []string) {
+	fs := flag.NewFlagSet("ledger diff", flag.ExitOnError)
+	dataPath := fs.String("data", "./obligations.xlsx", "Full-path to financial obligations data file (.xlsx, .ofx, or .qfx).")
+	since := fs.String("since", "", "Only count ledger activity on/after this date (YYYY-MM-DD). Required.")
+	fs.Parse(args)
+
+	if *since == "" {
+		fmt.Println("ledger diff requires -since YYYY-MM-DD")
+		os.Exit(1)
+	}
+
+	sinceDate, err := time.Parse("2006-01-02", *since)
+	checkErr(err)
+
+	journal, err := ledger.Open(ledger.DefaultPath(filepath.Dir(*dataPath)))
+	checkErr(err)
+
+	diffs, err := journal.DiffSince(sinceDate)
+	checkErr(err)
+
+	fmt.Print(ledger.Summary(diffs))
+}
@@ -0,0 +1,30 @@
+// Package loader reads financial obligations into memory from whatever
+// format the user's bank or spreadsheet happens to export.
+package loader
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/nomadicGopher/LiberaDebt/obligation"
+)
+
+// Loader reads obligations from a data file at dataPath.
+type Loader interface {
+	Load(dataPath string) ([]obligation.Obligation, error)
+}
+
+// New picks a Loader implementation based on the file extension of dataPath.
+// fallbackPayment is only consulted by loaders (currently OFXLoader) whose
+// source format doesn't always carry a minimum monthly payment.
+func New(dataPath string, fallbackPayment float64) (Loader, error) {
+	switch ext := strings.ToLower(filepath.Ext(dataPath)); ext {
+	case ".xlsx":
+		return XLSXLoader{}, nil
+	case ".ofx", ".qfx":
+		return OFXLoader{FallbackPayment: fallbackPayment}, nil
+	default:
+		return nil, fmt.Errorf("unsupported obligations file extension %q, expected .xlsx, .ofx, or .qfx", ext)
+	}
+}
@@ -0,0 +1,213 @@
+package loader
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aclindsa/ofxgo"
+	"github.com/nomadicGopher/LiberaDebt/obligation"
+)
+
+// OFXLoader reads obligations from an OFX 1.x (SGML) or OFX 2.x (XML)
+// bank-statement export, the kind produced by a bank, credit card issuer,
+// or an aggregator via ofxgo. It walks every BANKMSGSRSV1/CREDITCARDMSGSRSV1
+// statement in the file and synthesizes one Obligation per account.
+type OFXLoader struct {
+	// FallbackPayment is used as Obligation.Payment when a statement
+	// doesn't report a minimum payment. ofxgo v0.1.3 doesn't expose
+	// MINPMTDUE/REGPMT on StatementResponse/CCStatementResponse at all, so
+	// bank/credit-card accounts always fall back to this; loan accounts
+	// (see parseLoanSections) use it only when the statement itself is
+	// missing REGPMT/MINPMTDUE.
+	FallbackPayment float64
+}
+
+// loanMsgSetRE matches an entire LOANMSGSRSV1 message set, so it can be cut
+// out of the file before handing the remainder to ofxgo.
+var loanMsgSetRE = regexp.MustCompile(`(?is)<LOANMSGSRSV1>.*?</LOANMSGSRSV1>`)
+
+// loanStmtRE matches one LOANSTMTRS statement within a LOANMSGSRSV1 section.
+var loanStmtRE = regexp.MustCompile(`(?is)<LOANSTMTRS>.*?</LOANSTMTRS>`)
+
+// Load parses dataPath as an OFX file and returns one Obligation per
+// checking/savings/money-market/credit-line, credit card, or loan statement
+// found.
+func (l OFXLoader) Load(dataPath string) ([]obligation.Obligation, error) {
+	raw, err := os.ReadFile(dataPath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening OFX file: %v", err)
+	}
+
+	// ofxgo v0.1.3 doesn't model LOANMSGSRSV1 (it has no registered
+	// response types for LOANRS, see its response.go), so decoding a file
+	// containing one fails the whole parse -- bank/CC statements in the
+	// same file included. Hand-parse loan statements out of the raw file
+	// first, then strip the section out before handing the remainder to
+	// ofxgo so a mixed-type file still imports everything else.
+	loanObligations := l.parseLoanSections(raw)
+	stripped := loanMsgSetRE.ReplaceAll(raw, nil)
+
+	resp, err := ofxgo.ParseResponse(bytes.NewReader(stripped))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing OFX file: %v", err)
+	}
+
+	var obligations []obligation.Obligation
+
+	for _, msg := range resp.Bank {
+		stmt, ok := msg.(*ofxgo.StatementResponse)
+		if !ok {
+			continue
+		}
+		obligations = append(obligations, l.fromBankStatement(stmt))
+	}
+
+	for _, msg := range resp.CreditCard {
+		stmt, ok := msg.(*ofxgo.CCStatementResponse)
+		if !ok {
+			continue
+		}
+		obligations = append(obligations, l.fromCreditCardStatement(stmt))
+	}
+
+	obligations = append(obligations, loanObligations...)
+
+	if len(obligations) == 0 {
+		return nil, fmt.Errorf("no BANKMSGSRSV1, CREDITCARDMSGSRSV1, or LOANMSGSRSV1 statements found in OFX file %s", dataPath)
+	}
+
+	return obligations, nil
+}
+
+// fromBankStatement synthesizes an Obligation from a checking, savings,
+// money-market, CD, or credit-line account statement.
+func (l OFXLoader) fromBankStatement(stmt *ofxgo.StatementResponse) obligation.Obligation {
+	acctType := stmt.BankAcctFrom.AcctType.String()
+
+	balance, _ := stmt.BalAmt.Float64()
+
+	newObligation := obligation.Obligation{
+		Description: fmt.Sprintf("%s %s", acctType, string(stmt.BankAcctFrom.AcctID)),
+		Type:        acctType,
+		Payment:     l.FallbackPayment,
+	}
+
+	// A credit-line (overdraft) account carries debt as a negative ledger
+	// balance, same convention as a credit card. Any other bank account is
+	// only an obligation while overdrawn.
+	if balance < 0 {
+		newObligation.Balance = -balance
+	}
+
+	if stmt.IntRate != nil {
+		// Float64's second return value reports whether the float64 is an
+		// *exact* representation of the underlying big.Rat, not whether
+		// the value is usable -- nearly no realistic percentage (anything
+		// but a clean power-of-two fraction) round-trips exactly, so it's
+		// discarded here the same way BalAmt's is above.
+		rate, _ := stmt.IntRate.Float64()
+		newObligation.Interest = rate
+	}
+
+	return newObligation
+}
+
+// fromCreditCardStatement synthesizes an Obligation from a credit card
+// statement, where the ledger balance is negative when money is owed.
+func (l OFXLoader) fromCreditCardStatement(stmt *ofxgo.CCStatementResponse) obligation.Obligation {
+	balance, _ := stmt.BalAmt.Float64()
+	if balance < 0 {
+		balance = -balance
+	}
+
+	newObligation := obligation.Obligation{
+		Description: fmt.Sprintf("Credit Card %s", string(stmt.CCAcctFrom.AcctID)),
+		Type:        "Credit Card",
+		Balance:     balance,
+		Payment:     l.FallbackPayment,
+	}
+
+	// See fromBankStatement: Float64's ok return means "exact", not
+	// "present", so it's discarded; rate != 0 is the actual presence check
+	// since IntRatePurch is a value type, not a pointer.
+	if rate, _ := stmt.IntRatePurch.Float64(); rate != 0 {
+		newObligation.Interest = rate
+	}
+
+	return newObligation
+}
+
+// parseLoanSections hand-extracts one Obligation per LOANSTMTRS found in
+// raw's LOANMSGSRSV1 section(s), reading ACCTID/INTRATE (or LOANRATE)/
+// BALAMT/REGPMT (or MINPMTDUE) directly out of the SGML/XML rather than via
+// ofxgo, which doesn't type loan statements. A statement without ACCTID is
+// skipped; a missing BALAMT or rate leaves that field at zero, and a missing
+// payment leaves Payment at FallbackPayment, same as the bank/credit-card
+// paths above.
+func (l OFXLoader) parseLoanSections(raw []byte) []obligation.Obligation {
+	var obligations []obligation.Obligation
+
+	for _, stmt := range loanStmtRE.FindAllString(string(raw), -1) {
+		acctID, ok := tagValue(stmt, "ACCTID")
+		if !ok {
+			continue
+		}
+
+		newObligation := obligation.Obligation{
+			Description: fmt.Sprintf("Loan %s", acctID),
+			Type:        "Loan",
+			Payment:     l.FallbackPayment,
+		}
+
+		if balAmt, ok := tagValue(stmt, "BALAMT"); ok {
+			if balance, err := strconv.ParseFloat(balAmt, 64); err == nil {
+				if balance < 0 {
+					balance = -balance
+				}
+				newObligation.Balance = balance
+			}
+		}
+
+		if rate, ok := firstTagValue(stmt, "INTRATE", "LOANRATE"); ok {
+			if interest, err := strconv.ParseFloat(rate, 64); err == nil {
+				newObligation.Interest = interest
+			}
+		}
+
+		if payment, ok := firstTagValue(stmt, "REGPMT", "MINPMTDUE"); ok {
+			if amount, err := strconv.ParseFloat(payment, 64); err == nil {
+				newObligation.Payment = amount
+			}
+		}
+
+		obligations = append(obligations, newObligation)
+	}
+
+	return obligations
+}
+
+// tagValue returns the text of the first <tag>value element found in s.
+func tagValue(s, tag string) (string, bool) {
+	re := regexp.MustCompile(`(?i)<` + tag + `>\s*([^<\r\n]*)`)
+	match := re.FindStringSubmatch(s)
+	if match == nil {
+		return "", false
+	}
+
+	return strings.TrimSpace(match[1]), true
+}
+
+// firstTagValue returns the value of the first tag in tags that's present in s.
+func firstTagValue(s string, tags ...string) (string, bool) {
+	for _, tag := range tags {
+		if value, ok := tagValue(s, tag); ok {
+			return value, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,79 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeOFX writes contents to a temp .ofx file and returns its path.
+func writeOFX(t *testing.T, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "statement.ofx")
+	if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+		t.Fatalf("error writing test OFX file: %v", err)
+	}
+
+	return path
+}
+
+// A non-power-of-two-fraction interest rate like 24.99% doesn't round-trip
+// exactly through big.Rat.Float64(), so these rates are deliberately picked
+// to catch a regression that only assigns Interest when Float64() reports
+// an exact conversion.
+const ccStatementOFX = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+SECURITY:NONE
+ENCODING:USASCII
+CHARSET:1252
+COMPRESSION:NONE
+OLDFILEUID:NONE
+NEWFILEUID:NONE
+
+<OFX><SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO<MESSAGE>SUCCESS</STATUS><DTSERVER>20170331154648.331[-4:EDT]<LANGUAGE>ENG<FI><ORG>01<FID>81729</FI></SONRS></SIGNONMSGSRSV1><CREDITCARDMSGSRSV1><CCSTMTTRNRS><TRNUID>59e850ad-7448-b4ce-4b71-29057763b306<STATUS><CODE>0<SEVERITY>INFO</STATUS><CCSTMTRS><CURDEF>USD<CCACCTFROM><ACCTID>9283744488463775</CCACCTFROM><LEDGERBAL><BALAMT>-933.40<DTASOF>20170331080000.000[-4:EDT]</LEDGERBAL><INTRATEPURCH>24.99</CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1></OFX>`
+
+const bankStatementOFX = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+SECURITY:NONE
+ENCODING:USASCII
+CHARSET:1252
+COMPRESSION:NONE
+OLDFILEUID:NONE
+NEWFILEUID:NONE
+
+<OFX><SIGNONMSGSRSV1><SONRS><STATUS><CODE>0<SEVERITY>INFO<MESSAGE>SUCCESS</STATUS><DTSERVER>20170331154648.331[-4:EDT]<LANGUAGE>ENG<FI><ORG>01<FID>81729</FI></SONRS></SIGNONMSGSRSV1><BANKMSGSRSV1><STMTTRNRS><TRNUID>1<STATUS><CODE>0<SEVERITY>INFO</STATUS><STMTRS><CURDEF>USD<BANKACCTFROM><BANKID>1234<ACCTID>5678<ACCTTYPE>CREDITLINE</BANKACCTFROM><LEDGERBAL><BALAMT>-500<DTASOF>20170331080000.000[-4:EDT]</LEDGERBAL><INTRATE>6.99</STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>`
+
+func TestOFXLoader_CreditCardInterestSurvivesInexactFloat64(t *testing.T) {
+	path := writeOFX(t, ccStatementOFX)
+
+	obligations, err := OFXLoader{}.Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if len(obligations) != 1 {
+		t.Fatalf("expected 1 obligation, got %d: %+v", len(obligations), obligations)
+	}
+
+	if got := obligations[0].Interest; got != 24.99 {
+		t.Fatalf("expected Interest 24.99, got %v", got)
+	}
+}
+
+func TestOFXLoader_BankInterestSurvivesInexactFloat64(t *testing.T) {
+	path := writeOFX(t, bankStatementOFX)
+
+	obligations, err := OFXLoader{}.Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if len(obligations) != 1 {
+		t.Fatalf("expected 1 obligation, got %d: %+v", len(obligations), obligations)
+	}
+
+	if got := obligations[0].Interest; got != 6.99 {
+		t.Fatalf("expected Interest 6.99, got %v", got)
+	}
+}
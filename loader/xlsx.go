@@ -0,0 +1,119 @@
+package loader
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/nomadicGopher/LiberaDebt/obligation"
+	"github.com/tealeg/xlsx"
+)
+
+// XLSXLoader reads obligations from a spreadsheet (one obligation per row)
+// via tealeg/xlsx.
+type XLSXLoader struct{}
+
+// Load fetches data from dataPath & reads it into memory for use in other functions.
+func (XLSXLoader) Load(dataPath string) ([]obligation.Obligation, error) {
+	workBook, err := xlsx.OpenFile(dataPath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening XLSX workbook: %v", err)
+	}
+
+	sheet := workBook.Sheets[0]
+
+	if len(sheet.Rows) < 2 {
+		return nil, fmt.Errorf("no obligations (rows of data) exist in XLSX sheet")
+	}
+
+	// isRowEmpty checks if all cells in a row are empty.
+	isRowEmpty := func(row *xlsx.Row) bool {
+		for _, cell := range row.Cells {
+			if strings.TrimSpace(cell.Value) != "" {
+				return false
+			}
+		}
+		return true
+	}
+
+	var obligations []obligation.Obligation
+
+	for i, row := range sheet.Rows[1:] { // skip header row
+		if isRowEmpty(row) {
+			continue
+		}
+
+		xlsxRowNumber := i + 2
+
+		description := strings.TrimSpace(row.Cells[0].Value)    // Required
+		obligationType := strings.TrimSpace(row.Cells[1].Value) // Required
+		balance := strings.TrimSpace(row.Cells[2].Value)        // Optional
+		interest := strings.TrimSpace(row.Cells[3].Value)       // Optional
+		payment := strings.TrimSpace(row.Cells[4].Value)        // Required
+
+		// Ensure that required fields are populated with more than ""
+		if description == "" {
+			return nil, fmt.Errorf("xlsx row %d, Description is required but is empty", xlsxRowNumber)
+		}
+
+		if obligationType == "" {
+			return nil, fmt.Errorf("xlsx row %d, Type is required but is empty", xlsxRowNumber)
+		}
+
+		if payment == "" {
+			return nil, fmt.Errorf("xlsx row %d, Minimum Monthly Payment is required but is empty", xlsxRowNumber)
+		}
+
+		// Ensure input values convert to their appropriate types
+		var (
+			remainingBalanceFloat, interestRateFloat float64
+			err                                      error
+		)
+
+		if balance != "" {
+			remainingBalanceFloat, err = strconv.ParseFloat(balance, 64)
+			if err != nil {
+				return nil, fmt.Errorf("error formatting Total Remaining Balance from XLSX row %d: %v", xlsxRowNumber, err)
+			}
+		}
+
+		if interest != "" {
+			interestRateFloat, err = strconv.ParseFloat(interest, 64)
+			if err != nil {
+				return nil, fmt.Errorf("error formatting Interest Rate from XLSX row %d: %v", xlsxRowNumber, err)
+			}
+			// Convert decimal to percent if value is less than or equal to 1
+			if interestRateFloat <= 1.0 {
+				interestRateFloat = interestRateFloat * 100
+			}
+			// Round to 2 decimal places
+			interestRateFloat = math.Round(interestRateFloat*100) / 100
+		}
+
+		monthlyPaymentFloat, err := strconv.ParseFloat(payment, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error formatting Monthly Payment (required) from XLSX row %d: %v", xlsxRowNumber, err)
+		}
+
+		// Required Fields
+		newObligation := obligation.Obligation{
+			Description: description,
+			Type:        obligationType,
+			Payment:     monthlyPaymentFloat,
+		}
+
+		// Optional Fields
+		if remainingBalanceFloat != 0.00 {
+			newObligation.Balance = remainingBalanceFloat
+		}
+
+		if interestRateFloat != 0.00 {
+			newObligation.Interest = interestRateFloat
+		}
+
+		obligations = append(obligations, newObligation)
+	}
+
+	return obligations, nil
+}
@@ -3,10 +3,8 @@ package main
 import (
 	"bufio"
 	"context"
-	"encoding/json"
 	"flag"
 	"fmt"
-	"math"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -14,51 +12,146 @@ import (
 	"strings"
 	"time"
 
+	"github.com/nomadicGopher/LiberaDebt/config"
+	"github.com/nomadicGopher/LiberaDebt/ledger"
+	"github.com/nomadicGopher/LiberaDebt/loader"
+	"github.com/nomadicGopher/LiberaDebt/obligation"
+	"github.com/nomadicGopher/LiberaDebt/planner"
+	"github.com/nomadicGopher/LiberaDebt/prompt"
+	"github.com/nomadicGopher/LiberaDebt/server"
 	ollama "github.com/ollama/ollama/api"
-	"github.com/tealeg/xlsx"
 )
 
-type Obligations struct {
-	Obligations []Obligation `json:"obligations"`
-}
-
-// Obligation is the columns associated with each row of data. Required vs Optional
-// is controlled via logic found in getObligations().
-type Obligation struct {
-	Description string  `json:"Description"`                       // Required
-	Type        string  `json:"Type"`                              // Required
-	Balance     float64 `json:"Total Remaining Balance,omitempty"` // Optional
-	Interest    float64 `json:"Interest Rate %,omitempty"`         // Optional
-	Payment     float64 `json:"Minimum Monthly Payment"`           // Required
-}
-
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "ledger" {
+		runLedgerCommand(os.Args[2:])
+		return
+	}
+
 	const defaultGoal = "Provide a shortest-time payoff plan using any leftover budget for extra payments to loans and/or credit cards"
 
-	dataPath := flag.String("data", "./obligations.xlsx", "Full-path to financial obligations spreadsheet.")
+	dataPath := flag.String("data", "./obligations.xlsx", "Full-path to financial obligations data file (.xlsx, .ofx, or .qfx).")
 	income := flag.String("income", "", "User's monthly income (after taxes & deductions). Exclude $ and , characters.")
 	goal := flag.String("goal", defaultGoal, "User's financial goal for Ollama to provide advice for accomplishing.")
 	excludeThink := flag.Bool("excludeThink", true, "true to remove thinking content from the output file, false to keep it.")
 	model := flag.String("model", "qwen3:8b", "What Large Language Model will be used via Ollama?")
+	fallbackPayment := flag.Float64("fallbackPayment", 0, "Minimum Monthly Payment to use for OFX/QFX accounts that don't report one.")
+	strategy := flag.String("strategy", string(planner.Avalanche), "Payoff strategy for extra cash: avalanche, snowball, or highest-payment.")
+	noLLM := flag.Bool("noLLM", false, "true to emit the deterministic payoff plan without calling Ollama.")
+	configPath := flag.String("config", "", "Full-path to a liberadebt.toml/.yaml config file. Defaults to $XDG_CONFIG_HOME/liberadebt/ or $HOME/.liberadebt.toml.")
+	profileName := flag.String("profile", "", "Named profile to load from the config file. Flags passed at runtime still override the profile's values.")
+	ollamaHost := flag.String("ollamaHost", "", "Ollama host to connect to, e.g. http://localhost:11434. Defaults to $OLLAMA_HOST.")
+	promptTemplate := flag.String("promptTemplate", "", "Full-path to a text/template file to use instead of the built-in prompt.")
+	serveAddr := flag.String("serve", "", "Address to serve the HTTP API on, e.g. :8080. When set, liberadebt runs as a long-lived server instead of a one-shot CLI command.")
 	flag.Parse()
 
+	profile, err := loadProfile(*configPath, *profileName)
+	checkErr(err)
+	applyProfileDefaults(profile, dataPath, income, goal, model, strategy, ollamaHost, promptTemplate)
+
+	if *ollamaHost != "" {
+		checkErr(os.Setenv("OLLAMA_HOST", *ollamaHost))
+	}
+
+	if *serveAddr != "" {
+		srv := server.New(*dataPath, *fallbackPayment, *model, planner.Strategy(*strategy), *promptTemplate, profile)
+		checkErr(srv.Start(*serveAddr))
+		return
+	}
+
 	incomeFlt, err := determineIncome(*income)
 	checkErr(err)
 
 	*goal, err = determineGoal(*goal, defaultGoal)
 	checkErr(err)
 
-	obligations, err := getObligations(*dataPath)
+	dataLoader, err := loader.New(*dataPath, *fallbackPayment)
 	checkErr(err)
 
-	formattedObligations, err := formatObligations(obligations)
+	obligations, err := dataLoader.Load(*dataPath)
 	checkErr(err)
 
-	responseBuilder, err := promptOllama(incomeFlt, formattedObligations, *goal, *model)
+	obligations = profile.ApplyOverrides(obligations)
+
+	payoffPlan, err := planner.Plan(obligations, planner.Leftover(incomeFlt, obligations), planner.Strategy(*strategy))
+	checkErr(err)
+
+	journal, err := ledger.Open(ledger.DefaultPath(filepath.Dir(*dataPath)))
 	checkErr(err)
 
-	err = writeOutFile(*dataPath, *goal, *excludeThink, responseBuilder)
+	progressSummary, err := readProgress(journal)
 	checkErr(err)
+
+	formattedObligations, err := obligation.Format(obligations)
+	checkErr(err)
+
+	var responseBuilder strings.Builder
+	if !*noLLM {
+		responseBuilder, err = promptOllama(incomeFlt, formattedObligations, *goal, *model, *promptTemplate, payoffPlan, progressSummary)
+		checkErr(err)
+	}
+
+	err = writeOutFile(*dataPath, *goal, *excludeThink, payoffPlan, responseBuilder)
+	checkErr(err)
+}
+
+// loadProfile discovers and loads the named profile from the config file,
+// or returns a zero-value Profile if no profile was requested.
+func loadProfile(configFlag, profileName string) (config.Profile, error) {
+	if profileName == "" {
+		return config.Profile{}, nil
+	}
+
+	path, err := config.Discover(configFlag)
+	if err != nil {
+		return config.Profile{}, err
+	}
+	if path == "" {
+		return config.Profile{}, fmt.Errorf("profile %q requested but no config file was found", profileName)
+	}
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		return config.Profile{}, err
+	}
+
+	return cfg.Profile(profileName)
+}
+
+// applyProfileDefaults fills in any flag the user didn't explicitly pass
+// at runtime with the matching value from profile.
+func applyProfileDefaults(profile config.Profile, dataPath, income, goal, model, strategy, ollamaHost, promptTemplate *string) {
+	visited := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) { visited[f.Name] = true })
+
+	set := func(target *string, flagName, profileValue string) {
+		if !visited[flagName] && profileValue != "" {
+			*target = profileValue
+		}
+	}
+
+	set(dataPath, "data", profile.Data)
+	set(income, "income", profile.Income)
+	set(goal, "goal", profile.Goal)
+	set(model, "model", profile.Model)
+	set(strategy, "strategy", profile.Strategy)
+	set(ollamaHost, "ollamaHost", profile.OllamaHost)
+	set(promptTemplate, "promptTemplate", profile.PromptTemplate)
+}
+
+// readProgress returns a summary of ledger activity since last month, so
+// the Ollama prompt can be augmented with actual progress instead of only
+// the plan's predictions. It never writes to the ledger -- the plan
+// computed this run is only a projection, not anything that happened, so
+// recording actual progress is the explicit `liberadebt ledger record`
+// step instead of a side effect of generating a plan.
+func readProgress(journal *ledger.Journal) (string, error) {
+	diffs, err := journal.DiffSince(time.Now().AddDate(0, -1, 0))
+	if err != nil {
+		return "", err
+	}
+
+	return ledger.Summary(diffs), nil
 }
 
 // determineIncome checks the stdIn flags for an income. If none is found then the user is prompted to enter one.
@@ -122,124 +215,8 @@ func determineGoal(goal, defaultGoal string) (string, error) {
 	return goal, nil
 }
 
-// getObligations fetches data from obligations.xlsx & reads them into memory for use in other functions.
-func getObligations(dataPath string) (obligations []Obligation, _ error) {
-	workBook, err := xlsx.OpenFile(dataPath)
-	if err != nil {
-		return nil, fmt.Errorf("error opening XLSX workbook: %v", err)
-	}
-
-	sheet := workBook.Sheets[0]
-
-	if len(sheet.Rows) < 2 {
-		return nil, fmt.Errorf("no obligations (rows of data) exist in XLSX sheet")
-	}
-
-	// isRowEmpty checks if all cells in a row are empty.
-	isRowEmpty := func(row *xlsx.Row) bool {
-		for _, cell := range row.Cells {
-			if strings.TrimSpace(cell.Value) != "" {
-				return false
-			}
-		}
-		return true
-	}
-
-	for i, row := range sheet.Rows[1:] { // skip header row
-		if isRowEmpty(row) {
-			continue
-		}
-
-		xlsxRowNumber := i + 2
-
-		description := strings.TrimSpace(row.Cells[0].Value)    // Required
-		obligationType := strings.TrimSpace(row.Cells[1].Value) // Required
-		balance := strings.TrimSpace(row.Cells[2].Value)        // Optional
-		interest := strings.TrimSpace(row.Cells[3].Value)       // Optional
-		payment := strings.TrimSpace(row.Cells[4].Value)        // Required
-
-		// Ensure that required fields are populated with more than ""
-		if description == "" {
-			return nil, fmt.Errorf("xlsx row %d, Description is required but is empty", xlsxRowNumber)
-		}
-
-		if obligationType == "" {
-			return nil, fmt.Errorf("xlsx row %d, Type is required but is empty", xlsxRowNumber)
-		}
-
-		if payment == "" {
-			return nil, fmt.Errorf("xlsx row %d, Minimum Monthly Payment is required but is empty", xlsxRowNumber)
-		}
-
-		// Ensure input values convert to their appropriate types
-		var (
-			remainingBalanceFloat, interestRateFloat float64
-			err                                      error
-		)
-
-		if balance != "" {
-			remainingBalanceFloat, err = strconv.ParseFloat(balance, 64)
-			if err != nil {
-				return nil, fmt.Errorf("error formatting Total Remaining Balance from XLSX row %d: %v", xlsxRowNumber, err)
-			}
-		}
-
-		if interest != "" {
-			interestRateFloat, err = strconv.ParseFloat(interest, 64)
-			if err != nil {
-				return nil, fmt.Errorf("error formatting Interest Rate from XLSX row %d: %v", xlsxRowNumber, err)
-			}
-			// Convert decimal to percent if value is less than or equal to 1
-			if interestRateFloat <= 1.0 {
-				interestRateFloat = interestRateFloat * 100
-			}
-			// Round to 2 decimal places
-			interestRateFloat = math.Round(interestRateFloat*100) / 100
-		}
-
-		monthlyPaymentFloat, err := strconv.ParseFloat(payment, 64)
-		if err != nil {
-			return nil, fmt.Errorf("error formatting Monthly Payment (required) from XLSX row %d: %v", xlsxRowNumber, err)
-		}
-
-		// Required Fields
-		obligation := Obligation{
-			Description: description,
-			Type:        obligationType,
-			Payment:     monthlyPaymentFloat,
-		}
-
-		// Optional Fields
-		if remainingBalanceFloat != 0.00 {
-			obligation.Balance = remainingBalanceFloat
-		}
-
-		if interestRateFloat != 0.00 {
-			obligation.Interest = interestRateFloat
-		}
-
-		obligations = append(obligations, obligation)
-	}
-
-	return obligations, nil
-}
-
-// formatObligations concatenates xlsx.rows (obligations) into a single string which Ollama can understand.
-func formatObligations(obligations []Obligation) (formattedObligations string, _ error) {
-	for i, obligation := range obligations {
-		formattedObligation, err := json.Marshal(obligation)
-		if err != nil {
-			return "", fmt.Errorf("error marshaling obligation XLSX row #%d: %v", i+2, err)
-		}
-
-		formattedObligations = formattedObligations + string(formattedObligation)
-	}
-
-	return formattedObligations, nil
-}
-
 // promptOllama sets up the connection with Ollama & generates a request/response to stdOut and a .txt file.
-func promptOllama(incomeFlt float64, formattedObligations, goal, model string) (responseBuilder strings.Builder, _ error) {
+func promptOllama(incomeFlt float64, formattedObligations, goal, model, promptTemplatePath string, payoffPlan planner.PayoffPlan, progressSummary string) (responseBuilder strings.Builder, _ error) {
 	// Establish client & verify is running
 	client, err := ollama.ClientFromEnvironment()
 	if err != nil {
@@ -282,24 +259,25 @@ func promptOllama(incomeFlt float64, formattedObligations, goal, model string) (
 	}
 
 	// Prepare to generate response with Ollama
+	promptTmpl, err := prompt.Load(promptTemplatePath)
+	if err != nil {
+		return strings.Builder{}, err
+	}
+
+	renderedPrompt, err := prompt.Render(promptTmpl, prompt.Data{
+		Income:      incomeFlt,
+		Obligations: formattedObligations,
+		Plan:        payoffPlan.Summary(),
+		Progress:    progressSummary,
+		Goal:        goal,
+	})
+	if err != nil {
+		return strings.Builder{}, err
+	}
+
 	respReq := &ollama.GenerateRequest{
-		Model: model,
-		Prompt: fmt.Sprintf(`You are a cost-efficient financial planner.
-My monthly income is $%.2f.
-My obligations are %s.
-If no comparable leisure budget exists and at least 5 percent (x) of income remains, create a $x leisure expense.
-%s.
-If no money is leftover, let the user know and assume this plan is for when additional funds are available.
-Provide concise, actionable short-term and long-term steps with exact dollar amounts.
-Briefly explain your reasoning for each step.
-Only suggest extra payments for loans and credit cards.
-Do not consider user preferences or alternative scenarios; only provide the most efficient solution.
-Do not enumerate or compare multiple strategies.
-Do not respond with formulas or calculations for the user to perform.
-Do not list monthly expenses or bills in your response; they are for context only.
-Ignore the concepts of principal contributions as well as fixed vs variable interest rate types.
-Ensure no loan or credit card payment is counted or allocated more than once in any transactions or calculations.`,
-			incomeFlt, formattedObligations, goal),
+		Model:  model,
+		Prompt: renderedPrompt,
 	}
 
 	fmt.Printf("%s\n\n", respReq.Prompt)
@@ -323,8 +301,9 @@ Ensure no loan or credit card payment is counted or allocated more than once in
 	return responseBuilder, nil
 }
 
-// writeOutFile creates an output file and writes goal and response in the same directory as the data file
-func writeOutFile(dataPath, goal string, excludeThink bool, responseBuilder strings.Builder) error {
+// writeOutFile creates an output file and writes the goal, computed payoff
+// plan, and Ollama response (if any) in the same directory as the data file.
+func writeOutFile(dataPath, goal string, excludeThink bool, payoffPlan planner.PayoffPlan, responseBuilder strings.Builder) error {
 	now := time.Now()
 	outFileName := fmt.Sprintf("obligation_advice_%s.md",
 		now.Format("2006-01-02_15-04-05"))
@@ -337,6 +316,7 @@ func writeOutFile(dataPath, goal string, excludeThink bool, responseBuilder stri
 	defer outFile.Close()
 
 	fmt.Fprintf(outFile, "**Goal**: `%s`\n\n", goal)
+	fmt.Fprintf(outFile, "%s\n", payoffPlan.Table())
 	output := responseBuilder.String()
 	if excludeThink {
 		// remove all <think>...</think> blocks and any surrounding blank lines.
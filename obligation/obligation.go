@@ -0,0 +1,42 @@
+// Package obligation holds the data shape shared by every obligations
+// loader and consumer (XLSX/OFX loaders, the planner, the ledger, ...).
+package obligation
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Obligations is the top-level container used when an obligation set is
+// marshaled to or from JSON.
+type Obligations struct {
+	Obligations []Obligation `json:"obligations"`
+}
+
+// Obligation is the columns associated with each row of data. Required vs Optional
+// is controlled via logic found in each Loader implementation.
+type Obligation struct {
+	Description       string  `json:"Description"`                       // Required
+	Type              string  `json:"Type"`                              // Required
+	Balance           float64 `json:"Total Remaining Balance,omitempty"` // Optional
+	Interest          float64 `json:"Interest Rate %,omitempty"`         // Optional
+	Payment           float64 `json:"Minimum Monthly Payment"`           // Required
+	TaxDeductible     bool    `json:"Tax Deductible,omitempty"`          // Optional, set via config profile overrides
+	ExcludeFromPayoff bool    `json:"Exclude From Payoff,omitempty"`     // Optional, set via config profile overrides
+}
+
+// Format concatenates obligations into a single string which Ollama can
+// understand.
+func Format(obligations []Obligation) (string, error) {
+	var formatted string
+	for i, o := range obligations {
+		encoded, err := json.Marshal(o)
+		if err != nil {
+			return "", fmt.Errorf("error marshaling obligation #%d: %v", i+1, err)
+		}
+
+		formatted += string(encoded)
+	}
+
+	return formatted, nil
+}
@@ -0,0 +1,255 @@
+// Package planner computes a deterministic, month-by-month debt payoff
+// schedule so Ollama is given the real dollar amounts as ground truth
+// instead of being asked to do the arithmetic itself.
+package planner
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/nomadicGopher/LiberaDebt/obligation"
+)
+
+// Strategy decides which obligation receives all leftover cash each month.
+type Strategy string
+
+const (
+	Avalanche      Strategy = "avalanche"       // highest APR first
+	Snowball       Strategy = "snowball"        // smallest balance first
+	HighestPayment Strategy = "highest-payment" // frees up cash fastest
+)
+
+// Valid reports whether s is a Strategy Plan knows how to use.
+func (s Strategy) Valid() bool {
+	switch s {
+	case Avalanche, Snowball, HighestPayment:
+		return true
+	}
+	return false
+}
+
+// MonthlyAllocation is how much was put toward one obligation in one month
+// of the plan: its minimum plus any extra cash it received that month.
+type MonthlyAllocation struct {
+	Description string
+	Payment     float64
+	Interest    float64
+	Balance     float64 // remaining balance after this month's payment
+}
+
+// Month is one month of the plan, across every obligation still open.
+type Month struct {
+	Number      int
+	Allocations []MonthlyAllocation
+}
+
+// PayoffPlan is the deterministic amortization schedule for a set of
+// obligations under a chosen Strategy.
+type PayoffPlan struct {
+	Strategy          Strategy
+	Months            []Month
+	TotalInterestPaid float64
+	MonthsToPayoff    int
+	PayoffDates       map[string]time.Time // Obligation.Description -> the month it hits zero
+	// Converges is false when the plan still had an open balance at
+	// maxMonths -- the minimums (plus any leftover cash) never pay the
+	// debt off, so MonthsToPayoff is the loop bound, not a real timeline.
+	Converges bool
+}
+
+// account is an obligation with its own running balance as the plan
+// advances month by month. An excluded account still accrues interest and
+// receives its minimum payment every month like any other, it just never
+// ranks in targetOrder, so it never receives extra cash.
+type account struct {
+	obligation.Obligation
+	remaining float64
+	excluded  bool
+}
+
+// maxMonths guards against an under-funded plan that would never pay off.
+const maxMonths = 1200 // 100 years
+
+// Leftover is the monthly income remaining after every obligation's
+// required minimum payment, the pool of extra cash Plan allocates toward
+// payoff.
+func Leftover(incomeFlt float64, obligations []obligation.Obligation) float64 {
+	leftover := incomeFlt
+	for _, o := range obligations {
+		leftover -= o.Payment
+	}
+
+	if leftover < 0 {
+		return 0
+	}
+
+	return leftover
+}
+
+// Plan computes a month-by-month amortization for obligations, applying
+// leftoverCash each month (income beyond required minimums) to a single
+// target chosen by strategy. As each target reaches zero, its minimum
+// payment is rolled into the extra cash applied to the next target.
+func Plan(obligations []obligation.Obligation, leftoverCash float64, strategy Strategy) (PayoffPlan, error) {
+	if !strategy.Valid() {
+		return PayoffPlan{}, fmt.Errorf("unknown payoff strategy %q", strategy)
+	}
+
+	// Only obligations carrying an outstanding balance are amortized; a
+	// zero-balance row (e.g. an imported checking account) would never
+	// reach zero and has nothing to pay off. ExcludeFromPayoff lets a
+	// config profile opt an obligation out of receiving extra cash (e.g. a
+	// 0%-interest payment plan the user doesn't want funneled toward) --
+	// it stays in the amortization loop on minimum-only payments, it just
+	// never ranks in targetOrder.
+	var accounts []*account
+	for _, o := range obligations {
+		if o.Balance > 0 {
+			accounts = append(accounts, &account{Obligation: o, remaining: o.Balance, excluded: o.ExcludeFromPayoff})
+		}
+	}
+
+	order := targetOrder(accounts, strategy)
+
+	plan := PayoffPlan{
+		Strategy:    strategy,
+		PayoffDates: make(map[string]time.Time),
+	}
+
+	now := time.Now()
+
+	for month := 1; month <= maxMonths; month++ {
+		open := false
+		for _, acc := range accounts {
+			if acc.remaining > 0 {
+				open = true
+				break
+			}
+		}
+		if !open {
+			break
+		}
+
+		// Minimums already freed by any account (excluded or not) that
+		// reached zero in an earlier month roll forward into this month's
+		// extra cash.
+		extra := leftoverCash
+		for _, acc := range accounts {
+			if acc.remaining <= 0 {
+				extra += acc.Payment
+			}
+		}
+
+		allocations := make([]MonthlyAllocation, 0, len(accounts))
+		applyMinimum := func(acc *account) {
+			if acc.remaining <= 0 {
+				return
+			}
+
+			interest := acc.remaining * (acc.Interest / 12 / 100)
+			acc.remaining += interest
+			plan.TotalInterestPaid += interest
+
+			payment := acc.Payment
+			if payment > acc.remaining {
+				payment = acc.remaining
+			}
+			acc.remaining -= payment
+
+			allocations = append(allocations, MonthlyAllocation{
+				Description: acc.Description,
+				Payment:     payment,
+				Interest:    interest,
+				Balance:     acc.remaining,
+			})
+		}
+
+		for _, idx := range order {
+			applyMinimum(accounts[idx])
+		}
+		for _, acc := range accounts {
+			if acc.excluded {
+				applyMinimum(acc)
+			}
+		}
+
+		// All extra cash goes to the single highest-priority target still
+		// open; excluded accounts never appear in order, so they never
+		// receive it.
+		for _, idx := range order {
+			if extra <= 0 {
+				break
+			}
+			acc := accounts[idx]
+			if acc.remaining <= 0 {
+				continue
+			}
+
+			applied := extra
+			if applied > acc.remaining {
+				applied = acc.remaining
+			}
+			acc.remaining -= applied
+
+			for i := range allocations {
+				if allocations[i].Description == acc.Description {
+					allocations[i].Payment += applied
+					allocations[i].Balance = acc.remaining
+					break
+				}
+			}
+			break
+		}
+
+		plan.Months = append(plan.Months, Month{Number: month, Allocations: allocations})
+
+		for _, acc := range accounts {
+			if acc.remaining <= 0 {
+				if _, alreadyPaidOff := plan.PayoffDates[acc.Description]; !alreadyPaidOff {
+					plan.PayoffDates[acc.Description] = now.AddDate(0, month, 0)
+				}
+			}
+		}
+	}
+
+	plan.MonthsToPayoff = len(plan.Months)
+	plan.Converges = true
+	for _, acc := range accounts {
+		if acc.remaining > 0 {
+			plan.Converges = false
+			break
+		}
+	}
+
+	return plan, nil
+}
+
+// targetOrder ranks every non-excluded account by strategy once, up front;
+// the highest priority account still open in that fixed ranking always
+// receives the month's extra cash. Excluded accounts never appear.
+func targetOrder(accounts []*account, strategy Strategy) []int {
+	var order []int
+	for i, acc := range accounts {
+		if !acc.excluded {
+			order = append(order, i)
+		}
+	}
+
+	switch strategy {
+	case Avalanche:
+		sort.SliceStable(order, func(i, j int) bool {
+			return accounts[order[i]].Interest > accounts[order[j]].Interest
+		})
+	case Snowball:
+		sort.SliceStable(order, func(i, j int) bool {
+			return accounts[order[i]].remaining < accounts[order[j]].remaining
+		})
+	case HighestPayment:
+		sort.SliceStable(order, func(i, j int) bool {
+			return accounts[order[i]].Payment > accounts[order[j]].Payment
+		})
+	}
+
+	return order
+}
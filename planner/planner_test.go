@@ -0,0 +1,87 @@
+package planner
+
+import (
+	"testing"
+
+	"github.com/nomadicGopher/LiberaDebt/obligation"
+)
+
+func TestPlan_Converges(t *testing.T) {
+	obligations := []obligation.Obligation{
+		{Description: "Credit Card", Balance: 1000, Interest: 20, Payment: 200},
+	}
+
+	plan, err := Plan(obligations, 0, Avalanche)
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+
+	if !plan.Converges {
+		t.Fatalf("expected plan to converge, got MonthsToPayoff=%d", plan.MonthsToPayoff)
+	}
+	if plan.MonthsToPayoff == maxMonths {
+		t.Fatalf("expected a real payoff timeline, got the loop bound %d", maxMonths)
+	}
+}
+
+func TestPlan_ExcludeFromPayoffStaysOnMinimums(t *testing.T) {
+	obligations := []obligation.Obligation{
+		{Description: "Credit Card", Balance: 1000, Interest: 20, Payment: 100},
+		{Description: "0% Payment Plan", Balance: 600, Payment: 50, ExcludeFromPayoff: true},
+	}
+
+	plan, err := Plan(obligations, 200, Avalanche)
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+
+	if _, ok := plan.PayoffDates["0% Payment Plan"]; !ok {
+		t.Fatalf("expected excluded obligation to still reach a payoff date on minimums, got %+v", plan.PayoffDates)
+	}
+
+	month1, ok := allocation(plan.Months[0], "0% Payment Plan")
+	if !ok {
+		t.Fatalf("expected excluded obligation to appear in month 1's allocations")
+	}
+	if month1.Payment != 50 {
+		t.Fatalf("expected excluded obligation to receive only its $50 minimum, got $%.2f", month1.Payment)
+	}
+
+	cardMonth1, ok := allocation(plan.Months[0], "Credit Card")
+	if !ok {
+		t.Fatalf("expected Credit Card to appear in month 1's allocations")
+	}
+	if cardMonth1.Payment <= 100 {
+		t.Fatalf("expected the 200 leftover to flow to Credit Card, got only $%.2f", cardMonth1.Payment)
+	}
+}
+
+func allocation(month Month, description string) (MonthlyAllocation, bool) {
+	for _, a := range month.Allocations {
+		if a.Description == description {
+			return a, true
+		}
+	}
+	return MonthlyAllocation{}, false
+}
+
+func TestPlan_DoesNotConverge(t *testing.T) {
+	// A minimum payment that doesn't cover monthly interest accrual never
+	// pays the balance down; the loop should run out the clock at
+	// maxMonths and report that explicitly instead of a fake timeline.
+	obligations := []obligation.Obligation{
+		{Description: "Underfunded Loan", Balance: 10000, Interest: 36, Payment: 10},
+	}
+
+	plan, err := Plan(obligations, 0, Avalanche)
+	if err != nil {
+		t.Fatalf("Plan returned error: %v", err)
+	}
+
+	if plan.Converges {
+		t.Fatalf("expected plan not to converge")
+	}
+	if plan.MonthsToPayoff != maxMonths {
+		t.Fatalf("expected MonthsToPayoff to be the loop bound %d, got %d", maxMonths, plan.MonthsToPayoff)
+	}
+}
@@ -0,0 +1,60 @@
+package planner
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Summary renders a concise, human-readable description of the plan,
+// intended to be injected into the Ollama prompt as ground truth so the
+// model only has to explain the numbers, not compute them.
+func (p PayoffPlan) Summary() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Strategy: %s\n", p.Strategy)
+	if p.Converges {
+		fmt.Fprintf(&b, "Months to debt-free: %d\n", p.MonthsToPayoff)
+	} else {
+		fmt.Fprintf(&b, "Months to debt-free: does not pay off under current minimums (modeled %d months without reaching zero)\n", p.MonthsToPayoff)
+	}
+	fmt.Fprintf(&b, "Total interest paid: $%.2f\n", p.TotalInterestPaid)
+
+	// Map iteration order is randomized, so sort by description first --
+	// otherwise the same plan would render a different prompt (and risk
+	// different LLM phrasing) on every run.
+	descriptions := make([]string, 0, len(p.PayoffDates))
+	for description := range p.PayoffDates {
+		descriptions = append(descriptions, description)
+	}
+	sort.Strings(descriptions)
+
+	for _, description := range descriptions {
+		fmt.Fprintf(&b, "%s paid off by %s\n", description, p.PayoffDates[description].Format("January 2006"))
+	}
+
+	return b.String()
+}
+
+// Table renders the full month-by-month plan as a Markdown table.
+func (p PayoffPlan) Table() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## Payoff Plan (%s)\n\n", p.Strategy)
+	if p.Converges {
+		fmt.Fprintf(&b, "Months to debt-free: **%d**  \nTotal interest paid: **$%.2f**\n\n", p.MonthsToPayoff, p.TotalInterestPaid)
+	} else {
+		fmt.Fprintf(&b, "**Does not pay off under current minimums** (modeled %d months without reaching zero)  \nTotal interest paid: **$%.2f**\n\n", p.MonthsToPayoff, p.TotalInterestPaid)
+	}
+	b.WriteString("| Month | Obligation | Payment | Interest | Remaining Balance |\n")
+	b.WriteString("|---|---|---|---|---|\n")
+
+	for _, month := range p.Months {
+		for _, allocation := range month.Allocations {
+			fmt.Fprintf(&b, "| %d | %s | $%.2f | $%.2f | $%.2f |\n",
+				month.Number, allocation.Description, allocation.Payment, allocation.Interest, allocation.Balance)
+		}
+	}
+
+	return b.String()
+}
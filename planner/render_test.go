@@ -0,0 +1,32 @@
+package planner
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSummary_PayoffDatesAreSortedDeterministically(t *testing.T) {
+	plan := PayoffPlan{
+		Strategy:  Avalanche,
+		Converges: true,
+		PayoffDates: map[string]time.Time{
+			"Zebra Loan":     time.Date(2027, time.March, 1, 0, 0, 0, 0, time.UTC),
+			"Amex Card":      time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+			"Mortgage":       time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+			"Chase Sapphire": time.Date(2026, time.August, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	want := "Amex Card paid off by October 2026\n" +
+		"Chase Sapphire paid off by August 2026\n" +
+		"Mortgage paid off by January 2030\n" +
+		"Zebra Loan paid off by March 2027\n"
+
+	for i := 0; i < 5; i++ {
+		summary := plan.Summary()
+		if !strings.Contains(summary, want) {
+			t.Fatalf("run %d: expected payoff lines sorted by description, got:\n%s", i, summary)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Package prompt renders the text/template sent to Ollama, so the wording
+// can be iterated on (via a profile's prompt_template) without recompiling.
+package prompt
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+//go:embed default.tmpl
+var defaultTemplate string
+
+// Data is everything the prompt template can reference.
+type Data struct {
+	Income      float64
+	Obligations string
+	Plan        string
+	Progress    string
+	Goal        string
+}
+
+// Load parses the template at path, or the built-in default prompt if
+// path is empty.
+func Load(path string) (*template.Template, error) {
+	if path == "" {
+		return template.New("prompt").Parse(defaultTemplate)
+	}
+
+	tmpl, err := template.ParseFiles(path)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing prompt template %s: %v", path, err)
+	}
+
+	return tmpl, nil
+}
+
+// Render executes tmpl against data and returns the resulting prompt text.
+func Render(tmpl *template.Template, data Data) (string, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("error rendering prompt template: %v", err)
+	}
+
+	return b.String(), nil
+}
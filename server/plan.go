@@ -0,0 +1,210 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/nomadicGopher/LiberaDebt/loader"
+	"github.com/nomadicGopher/LiberaDebt/obligation"
+	"github.com/nomadicGopher/LiberaDebt/planner"
+	"github.com/nomadicGopher/LiberaDebt/prompt"
+	ollama "github.com/ollama/ollama/api"
+)
+
+const defaultGoal = "Provide a shortest-time payoff plan using any leftover budget for extra payments to loans and/or credit cards"
+
+// handlePlan computes a payoff plan from the request's obligations and
+// streams Ollama's explanation of it back as Server-Sent Events.
+func (s *Server) handlePlan(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	obligations, income, goal, strategy, err := s.parsePlanRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	payoffPlan, err := planner.Plan(obligations, planner.Leftover(income, obligations), strategy)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	s.streamPlan(w, r, income, obligations, goal, payoffPlan)
+}
+
+// parsePlanRequest reads obligations, income, goal, and strategy from a
+// JSON or multipart POST /plan body. A JSON body carries no file, so its
+// obligations are loaded from the server's configured DataPath; a
+// multipart body uploads its own XLSX/OFX/QFX file.
+func (s *Server) parsePlanRequest(r *http.Request) (obligations []obligation.Obligation, income float64, goal string, strategy planner.Strategy, _ error) {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+		return s.parsePlanMultipart(r)
+	}
+
+	var body struct {
+		Income   float64 `json:"income"`
+		Goal     string  `json:"goal"`
+		Strategy string  `json:"strategy"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, 0, "", "", fmt.Errorf("error decoding plan request: %v", err)
+	}
+
+	dataLoader, err := loader.New(s.DataPath, s.FallbackPayment)
+	if err != nil {
+		return nil, 0, "", "", err
+	}
+
+	obligations, err = dataLoader.Load(s.DataPath)
+	if err != nil {
+		return nil, 0, "", "", err
+	}
+
+	return s.Profile.ApplyOverrides(obligations), body.Income, s.goalOrDefault(body.Goal), s.strategyOrDefault(body.Strategy), nil
+}
+
+// parsePlanMultipart reads income/goal/strategy form values plus an
+// uploaded "file" field (an XLSX/OFX/QFX obligations file) from r.
+func (s *Server) parsePlanMultipart(r *http.Request) (obligations []obligation.Obligation, income float64, goal string, strategy planner.Strategy, _ error) {
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		return nil, 0, "", "", fmt.Errorf("error parsing multipart form: %v", err)
+	}
+
+	income, err := strconv.ParseFloat(r.FormValue("income"), 64)
+	if err != nil {
+		return nil, 0, "", "", fmt.Errorf("error parsing income: %v", err)
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		return nil, 0, "", "", fmt.Errorf("error reading uploaded file: %v", err)
+	}
+	defer file.Close()
+
+	tmpFile, err := os.CreateTemp("", "liberadebt-upload-*"+filepath.Ext(header.Filename))
+	if err != nil {
+		return nil, 0, "", "", fmt.Errorf("error creating temp file for uploaded data: %v", err)
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	if _, err := io.Copy(tmpFile, file); err != nil {
+		return nil, 0, "", "", fmt.Errorf("error saving uploaded data: %v", err)
+	}
+
+	dataLoader, err := loader.New(tmpFile.Name(), s.FallbackPayment)
+	if err != nil {
+		return nil, 0, "", "", err
+	}
+
+	obligations, err = dataLoader.Load(tmpFile.Name())
+	if err != nil {
+		return nil, 0, "", "", err
+	}
+
+	return s.Profile.ApplyOverrides(obligations), income, s.goalOrDefault(r.FormValue("goal")), s.strategyOrDefault(r.FormValue("strategy")), nil
+}
+
+func (s *Server) goalOrDefault(goal string) string {
+	if goal == "" {
+		return defaultGoal
+	}
+
+	return goal
+}
+
+func (s *Server) strategyOrDefault(strategy string) planner.Strategy {
+	if strategy == "" {
+		return s.Strategy
+	}
+
+	return planner.Strategy(strategy)
+}
+
+// streamPlan renders the prompt for obligations/payoffPlan and streams
+// Ollama's response back to w as Server-Sent Events, one "data:" frame per
+// chunk of generated text.
+func (s *Server) streamPlan(w http.ResponseWriter, r *http.Request, income float64, obligations []obligation.Obligation, goal string, payoffPlan planner.PayoffPlan) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	client, err := ollama.ClientFromEnvironment()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error creating an Ollama client: %v", err), http.StatusBadGateway)
+		return
+	}
+
+	if err := client.Heartbeat(r.Context()); err != nil {
+		http.Error(w, "error connecting to the Ollama server, ensure it's running elsewhere with $ ollama serve", http.StatusBadGateway)
+		return
+	}
+
+	formattedObligations, err := obligation.Format(obligations)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	promptTmpl, err := prompt.Load(s.PromptTemplatePath)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	renderedPrompt, err := prompt.Render(promptTmpl, prompt.Data{
+		Income:      income,
+		Obligations: formattedObligations,
+		Plan:        payoffPlan.Summary(),
+		Progress:    "Progress tracking against the ledger isn't available over the HTTP API; treat this as a fresh plan.",
+		Goal:        goal,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	respReq := &ollama.GenerateRequest{
+		Model:  s.Model,
+		Prompt: renderedPrompt,
+	}
+
+	respFunc := func(resp ollama.GenerateResponse) error {
+		encoded, err := json.Marshal(resp.Response)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w, "data: %s\n\n", encoded)
+		flusher.Flush()
+
+		return nil
+	}
+
+	if err := client.Generate(r.Context(), respReq, respFunc); err != nil {
+		encoded, _ := json.Marshal(err.Error())
+		fmt.Fprintf(w, "event: error\ndata: %s\n\n", encoded)
+		flusher.Flush()
+		return
+	}
+
+	fmt.Fprint(w, "event: done\ndata: {}\n\n")
+	flusher.Flush()
+}
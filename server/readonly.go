@@ -0,0 +1,102 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/nomadicGopher/LiberaDebt/loader"
+	"github.com/nomadicGopher/LiberaDebt/obligation"
+	"github.com/nomadicGopher/LiberaDebt/planner"
+	ollama "github.com/ollama/ollama/api"
+)
+
+// handleObligations returns the obligations loaded from the server's
+// configured data file as JSON.
+func (s *Server) handleObligations(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	obligations, err := s.loadObligations()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, obligation.Obligations{Obligations: obligations})
+}
+
+// handlePlanJSON returns the deterministic payoff plan for the server's
+// configured data file, without calling Ollama. income is required and
+// strategy is optional, both as query parameters.
+func (s *Server) handlePlanJSON(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	income, err := strconv.ParseFloat(r.URL.Query().Get("income"), 64)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("error parsing income query parameter: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	strategy := s.strategyOrDefault(r.URL.Query().Get("strategy"))
+
+	obligations, err := s.loadObligations()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	payoffPlan, err := planner.Plan(obligations, planner.Leftover(income, obligations), strategy)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	writeJSON(w, payoffPlan)
+}
+
+// handleHealthz reports whether Ollama is reachable, proxying
+// client.Heartbeat.
+func (s *Server) handleHealthz(w http.ResponseWriter, r *http.Request) {
+	client, err := ollama.ClientFromEnvironment()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	if err := client.Heartbeat(r.Context()); err != nil {
+		http.Error(w, "ollama unreachable", http.StatusServiceUnavailable)
+		return
+	}
+
+	writeJSON(w, map[string]string{"status": "ok"})
+}
+
+// loadObligations loads obligations from the server's configured DataPath,
+// with the server's profile overrides applied.
+func (s *Server) loadObligations() ([]obligation.Obligation, error) {
+	dataLoader, err := loader.New(s.DataPath, s.FallbackPayment)
+	if err != nil {
+		return nil, err
+	}
+
+	obligations, err := dataLoader.Load(s.DataPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Profile.ApplyOverrides(obligations), nil
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		fmt.Println(err)
+	}
+}
@@ -0,0 +1,53 @@
+// Package server exposes liberadebt's loader/planner pipeline over HTTP,
+// for callers that want a long-lived process instead of one-shot CLI runs.
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/nomadicGopher/LiberaDebt/config"
+	"github.com/nomadicGopher/LiberaDebt/planner"
+)
+
+// Server holds the defaults applied to every request: the data file to
+// read obligations from when a request doesn't upload its own, the model
+// and prompt template used for every request, the strategy to fall back on
+// when a request doesn't specify one, and the profile whose overrides (tax-deductible,
+// exclude-from-payoff, min-payment-floor) apply to every obligation set
+// this server loads, whether from DataPath or an upload.
+type Server struct {
+	DataPath           string
+	FallbackPayment    float64
+	Model              string
+	Strategy           planner.Strategy
+	PromptTemplatePath string
+	Profile            config.Profile
+}
+
+// New returns a Server configured with the CLI's flag values and resolved
+// profile as defaults.
+func New(dataPath string, fallbackPayment float64, model string, strategy planner.Strategy, promptTemplatePath string, profile config.Profile) *Server {
+	return &Server{
+		DataPath:           dataPath,
+		FallbackPayment:    fallbackPayment,
+		Model:              model,
+		Strategy:           strategy,
+		PromptTemplatePath: promptTemplatePath,
+		Profile:            profile,
+	}
+}
+
+// Start blocks, serving the HTTP API on addr until the process exits or
+// ListenAndServe returns an error.
+func (s *Server) Start(addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/plan", s.handlePlan)
+	mux.HandleFunc("/obligations", s.handleObligations)
+	mux.HandleFunc("/plan.json", s.handlePlanJSON)
+	mux.HandleFunc("/healthz", s.handleHealthz)
+
+	fmt.Printf("Listening on %s\n", addr)
+
+	return http.ListenAndServe(addr, mux)
+}